@@ -0,0 +1,42 @@
+package quaternion
+
+// This file holds the pure-Go implementations of the batch operations over
+// slices of Quaternions. Each hot loop here is straight-line and allocates
+// nothing per element, making it an easy target for an arch-specific
+// assembly implementation (AVX2 on amd64, NEON on arm64). See
+// batch_dispatch.go for the GOARCH-selected indirection that a future
+// assembly implementation would hook into; these Generic functions are
+// registered there as the fallback used by every architecture today.
+
+// prodBatchGeneric computes dst[i] = a[i]*b[i] for every element. dst, a,
+// and b must have the same length; dst may alias a or b.
+func prodBatchGeneric(dst, a, b []Quaternion) {
+	for i := range dst {
+		dst[i] = a[i].Mul(b[i])
+	}
+}
+
+// rotateBatchGeneric computes dst[i] = q.Rotate(pts[i]) for every element.
+// dst and pts must have the same length; dst may alias pts.
+func rotateBatchGeneric(dst [][3]float64, q Quaternion, pts [][3]float64) {
+	for i := range dst {
+		dst[i] = q.Rotate(pts[i])
+	}
+}
+
+// normalizeBatchGeneric rescales every Quaternion in qs to unit norm in
+// place.
+func normalizeBatchGeneric(qs []Quaternion) {
+	for i := range qs {
+		qs[i] = qs[i].Unit()
+	}
+}
+
+// slerpBatchGeneric computes dst[i] = Slerp(a[i], b[i], ts[i]) for every
+// element. dst, a, b, and ts must have the same length; dst may alias a or
+// b.
+func slerpBatchGeneric(dst, a, b []Quaternion, ts []float64) {
+	for i := range dst {
+		dst[i] = Slerp(a[i], b[i], ts[i])
+	}
+}
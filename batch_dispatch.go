@@ -0,0 +1,48 @@
+package quaternion
+
+import "runtime"
+
+// This file selects the implementation behind each exported batch
+// operation by GOARCH, so an assembly implementation (AVX2 on amd64, NEON
+// on arm64) can later be dropped in without touching any call site: add a
+// //go:noescape stub for the new architecture and assign it to the
+// corresponding variable below inside the matching GOARCH case. No such
+// assembly exists yet, so every architecture is registered to the pure-Go
+// fallback in batch.go.
+var (
+	prodBatchFunc      = prodBatchGeneric
+	rotateBatchFunc    = rotateBatchGeneric
+	normalizeBatchFunc = normalizeBatchGeneric
+	slerpBatchFunc     = slerpBatchGeneric
+)
+
+func init() {
+	switch runtime.GOARCH {
+	case "amd64", "arm64":
+		// Reserved for future AVX2/NEON implementations; falls through to
+		// the pure-Go registration above until one exists.
+	}
+}
+
+// ProdBatch computes dst[i] = a[i]*b[i] for every element. dst, a, and b
+// must have the same length; dst may alias a or b.
+func ProdBatch(dst, a, b []Quaternion) {
+	prodBatchFunc(dst, a, b)
+}
+
+// RotateBatch computes dst[i] = q.Rotate(pts[i]) for every element. dst and
+// pts must have the same length; dst may alias pts.
+func RotateBatch(dst [][3]float64, q Quaternion, pts [][3]float64) {
+	rotateBatchFunc(dst, q, pts)
+}
+
+// NormalizeBatch rescales every Quaternion in qs to unit norm in place.
+func NormalizeBatch(qs []Quaternion) {
+	normalizeBatchFunc(qs)
+}
+
+// SlerpBatch computes dst[i] = Slerp(a[i], b[i], ts[i]) for every element.
+// dst, a, b, and ts must have the same length; dst may alias a or b.
+func SlerpBatch(dst, a, b []Quaternion, ts []float64) {
+	slerpBatchFunc(dst, a, b, ts)
+}
@@ -0,0 +1,113 @@
+package quaternion
+
+import "testing"
+
+func benchData(n int) (a, b []Quaternion, pts [][3]float64, ts []float64) {
+	a = make([]Quaternion, n)
+	b = make([]Quaternion, n)
+	pts = make([][3]float64, n)
+	ts = make([]float64, n)
+	for i := range a {
+		a[i] = FromAxisAngle([3]float64{1, 0, 0}, float64(i)*0.001)
+		b[i] = FromAxisAngle([3]float64{0, 1, 0}, float64(i)*0.002)
+		pts[i] = [3]float64{float64(i), float64(i) * 0.5, float64(i) * 0.25}
+		ts[i] = float64(i%100) / 100
+	}
+	return a, b, pts, ts
+}
+
+func TestProdBatch(t *testing.T) {
+	n := 37
+	qa, qb, _, _ := benchData(n)
+	dst := make([]Quaternion, n)
+	ProdBatch(dst, qa, qb)
+	for i := range dst {
+		want := qa[i].Mul(qb[i])
+		if dst[i] != want {
+			t.Errorf("ProdBatch[%d] = %v, want %v", i, dst[i], want)
+		}
+	}
+}
+
+func TestRotateBatch(t *testing.T) {
+	n := 37
+	qa, _, pts, _ := benchData(n)
+	q := qa[3]
+	dst := make([][3]float64, n)
+	RotateBatch(dst, q, pts)
+	for i := range dst {
+		want := q.Rotate(pts[i])
+		if dst[i] != want {
+			t.Errorf("RotateBatch[%d] = %v, want %v", i, dst[i], want)
+		}
+	}
+}
+
+func TestNormalizeBatch(t *testing.T) {
+	n := 37
+	qa, _, _, _ := benchData(n)
+	qs := make([]Quaternion, n)
+	copy(qs, qa)
+	NormalizeBatch(qs)
+	for i := range qs {
+		want := qa[i].Unit()
+		if qs[i] != want {
+			t.Errorf("NormalizeBatch[%d] = %v, want %v", i, qs[i], want)
+		}
+	}
+}
+
+func TestSlerpBatch(t *testing.T) {
+	n := 37
+	qa, qb, _, ts := benchData(n)
+	dst := make([]Quaternion, n)
+	SlerpBatch(dst, qa, qb, ts)
+	for i := range dst {
+		want := Slerp(qa[i], qb[i], ts[i])
+		if dst[i] != want {
+			t.Errorf("SlerpBatch[%d] = %v, want %v", i, dst[i], want)
+		}
+	}
+}
+
+func BenchmarkProdBatch(b *testing.B) {
+	n := 1024
+	qa, qb, _, _ := benchData(n)
+	dst := make([]Quaternion, n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ProdBatch(dst, qa, qb)
+	}
+}
+
+func BenchmarkRotateBatch(bb *testing.B) {
+	n := 1024
+	qa, _, pts, _ := benchData(n)
+	dst := make([][3]float64, n)
+	q := qa[0]
+	bb.ResetTimer()
+	for i := 0; i < bb.N; i++ {
+		RotateBatch(dst, q, pts)
+	}
+}
+
+func BenchmarkNormalizeBatch(b *testing.B) {
+	n := 1024
+	qa, _, _, _ := benchData(n)
+	qs := make([]Quaternion, n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(qs, qa)
+		NormalizeBatch(qs)
+	}
+}
+
+func BenchmarkSlerpBatch(b *testing.B) {
+	n := 1024
+	qa, qb, _, ts := benchData(n)
+	dst := make([]Quaternion, n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SlerpBatch(dst, qa, qb, ts)
+	}
+}
@@ -0,0 +1,120 @@
+package quaternion
+
+import (
+	"math"
+)
+
+// DualQuaternion represents a dual quaternion Real + Dual*epsilon, the
+// standard representation for a rigid-body (SE(3)) transform: Real encodes
+// the rotation and Dual encodes the translation coupled to it. This is the
+// representation used for skeletal skinning and smooth rigid-body motion
+// interpolation (see ScLERP). Real is expected to be a unit Quaternion.
+type DualQuaternion struct {
+	Real Quaternion
+	Dual Quaternion
+}
+
+// FromRotationTranslation returns the DualQuaternion representing a
+// rotation by unit Quaternion q followed by a translation by t.
+func FromRotationTranslation(q Quaternion, t [3]float64) DualQuaternion {
+	tq := Quaternion{X: t[0], Y: t[1], Z: t[2]}
+	d := tq.Mul(q)
+	return DualQuaternion{
+		Real: q,
+		Dual: Quaternion{W: 0.5 * d.W, X: 0.5 * d.X, Y: 0.5 * d.Y, Z: 0.5 * d.Z},
+	}
+}
+
+// Conj returns the conjugate of d, conjugating both the real and dual
+// parts. For a unit DualQuaternion this is also its inverse as a rigid-body
+// transform.
+func (d DualQuaternion) Conj() DualQuaternion {
+	return DualQuaternion{Real: d.Real.Conj(), Dual: d.Dual.Conj()}
+}
+
+// Mul returns the non-commutative dual-quaternion product d*r, combining
+// the two rigid-body transforms (r applied first, then d).
+func (d DualQuaternion) Mul(r DualQuaternion) DualQuaternion {
+	return DualQuaternion{
+		Real: d.Real.Mul(r.Real),
+		Dual: d.Real.Mul(r.Dual).Add(d.Dual.Mul(r.Real)),
+	}
+}
+
+// Translation extracts the translation vector encoded by d, assuming Real
+// is a unit Quaternion.
+func (d DualQuaternion) Translation() [3]float64 {
+	t := d.Dual.Mul(d.Real.Conj())
+	return [3]float64{2 * t.X, 2 * t.Y, 2 * t.Z}
+}
+
+// Transform applies the rigid-body transform represented by d to point p,
+// assuming Real is a unit Quaternion.
+func (d DualQuaternion) Transform(p [3]float64) [3]float64 {
+	rotated := d.Real.Rotate(p)
+	t := d.Translation()
+	return [3]float64{rotated[0] + t[0], rotated[1] + t[1], rotated[2] + t[2]}
+}
+
+// dualEps is the sin(theta/2) magnitude below which a DualQuaternion's
+// rotation is treated as identity (pure translation) when decomposing it
+// into screw parameters.
+const dualEps = 1e-9
+
+// screw decomposes a unit DualQuaternion into its screw parameters: the
+// rotation angle theta about unit axis n, and the translation distance
+// dist along n combined with perpendicular moment m. See Kenwright, "A
+// Beginners Guide to Dual-Quaternions" (2012).
+func (d DualQuaternion) screw() (theta float64, n [3]float64, dist float64, m [3]float64) {
+	sinHalf := math.Sqrt(d.Real.X*d.Real.X + d.Real.Y*d.Real.Y + d.Real.Z*d.Real.Z)
+	if sinHalf < dualEps {
+		// No rotation: the whole motion is a translation, which the screw
+		// form carries as distance along the translation direction itself.
+		t := [3]float64{2 * d.Dual.X, 2 * d.Dual.Y, 2 * d.Dual.Z}
+		dist = math.Sqrt(t[0]*t[0] + t[1]*t[1] + t[2]*t[2])
+		if dist < dualEps {
+			return 0, [3]float64{}, 0, [3]float64{}
+		}
+		return 0, [3]float64{t[0] / dist, t[1] / dist, t[2] / dist}, dist, [3]float64{}
+	}
+	cosHalf := d.Real.W
+	theta = 2 * math.Atan2(sinHalf, cosHalf)
+	n = [3]float64{d.Real.X / sinHalf, d.Real.Y / sinHalf, d.Real.Z / sinHalf}
+	dist = -2 * d.Dual.W / sinHalf
+	m = [3]float64{
+		(d.Dual.X - dist/2*cosHalf*n[0]) / sinHalf,
+		(d.Dual.Y - dist/2*cosHalf*n[1]) / sinHalf,
+		(d.Dual.Z - dist/2*cosHalf*n[2]) / sinHalf,
+	}
+	return theta, n, dist, m
+}
+
+// fromScrew reconstructs the unit DualQuaternion for the screw motion of
+// angle theta about unit axis n, with translation dist along n and
+// perpendicular moment m.
+func fromScrew(theta float64, n [3]float64, dist float64, m [3]float64) DualQuaternion {
+	sinHalf, cosHalf := math.Sincos(theta / 2)
+	return DualQuaternion{
+		Real: Quaternion{W: cosHalf, X: sinHalf * n[0], Y: sinHalf * n[1], Z: sinHalf * n[2]},
+		Dual: Quaternion{
+			W: -dist / 2 * sinHalf,
+			X: sinHalf*m[0] + dist/2*cosHalf*n[0],
+			Y: sinHalf*m[1] + dist/2*cosHalf*n[1],
+			Z: sinHalf*m[2] + dist/2*cosHalf*n[2],
+		},
+	}
+}
+
+// ScLERP returns the screw linear interpolation between rigid-body
+// transforms a and b at t in [0,1], the dual-quaternion analogue of Slerp
+// that smoothly interpolates combined rotation and translation along a
+// single helical (screw) motion.
+func ScLERP(a, b DualQuaternion, t float64) DualQuaternion {
+	if a.Real.Dot(b.Real) < 0 {
+		b = DualQuaternion{Real: b.Real.Neg(), Dual: b.Dual.Neg()}
+	}
+	rel := a.Conj().Mul(b)
+	theta, n, dist, m := rel.screw()
+	relT := fromScrew(t*theta, n, t*dist, m)
+	return a.Mul(relT)
+}
@@ -0,0 +1,57 @@
+package quaternion
+
+import (
+	"math"
+	"testing"
+)
+
+func quatApproxEqualUpToSign(a, b Quaternion, eps float64) bool {
+	same := math.Abs(a.W-b.W) < eps && math.Abs(a.X-b.X) < eps && math.Abs(a.Y-b.Y) < eps && math.Abs(a.Z-b.Z) < eps
+	neg := math.Abs(a.W+b.W) < eps && math.Abs(a.X+b.X) < eps && math.Abs(a.Y+b.Y) < eps && math.Abs(a.Z+b.Z) < eps
+	return same || neg
+}
+
+func TestFromRotMatRoundTrip(t *testing.T) {
+	cases := []Quaternion{
+		{W: 1},
+		FromAxisAngle([3]float64{1, 0, 0}, 1.1),
+		FromAxisAngle([3]float64{0, 1, 0}, 2.4),
+		FromAxisAngle([3]float64{1, 2, 3}, -0.8),
+		FromAxisAngle([3]float64{1, -1, 2}, math.Pi-1e-6),
+	}
+	for _, q := range cases {
+		got := FromRotMat(q.RotMat())
+		if !quatApproxEqualUpToSign(got, q, 1e-9) {
+			t.Errorf("FromRotMat(q.RotMat()) = %v, want %v (up to sign)", got, q)
+		}
+		if !rotMatApproxEqual(got.RotMat(), q.RotMat(), 1e-9) {
+			t.Errorf("FromRotMat(q.RotMat()).RotMat() does not match q.RotMat() for q=%v", q)
+		}
+	}
+}
+
+func TestScLERPEndpoints(t *testing.T) {
+	a := FromRotationTranslation(FromAxisAngle([3]float64{0, 0, 1}, 0.3), [3]float64{1, 2, 3})
+	b := FromRotationTranslation(FromAxisAngle([3]float64{1, 0, 0}, 1.2), [3]float64{4, -1, 2})
+
+	got0 := ScLERP(a, b, 0)
+	if !quatApproxEqualUpToSign(got0.Real, a.Real, 1e-9) || !vecApproxEqual(got0.Translation(), a.Translation(), 1e-9) {
+		t.Errorf("ScLERP(a,b,0) = %+v, want a = %+v", got0, a)
+	}
+
+	got1 := ScLERP(a, b, 1)
+	if !quatApproxEqualUpToSign(got1.Real, b.Real, 1e-9) || !vecApproxEqual(got1.Translation(), b.Translation(), 1e-9) {
+		t.Errorf("ScLERP(a,b,1) = %+v, want b = %+v", got1, b)
+	}
+}
+
+func TestScLERPPureTranslation(t *testing.T) {
+	a := FromRotationTranslation(Quaternion{W: 1}, [3]float64{0, 0, 0})
+	b := FromRotationTranslation(Quaternion{W: 1}, [3]float64{2, 4, 6})
+
+	mid := ScLERP(a, b, 0.5)
+	want := [3]float64{1, 2, 3}
+	if !vecApproxEqual(mid.Translation(), want, 1e-9) {
+		t.Errorf("ScLERP pure-translation midpoint = %v, want %v", mid.Translation(), want)
+	}
+}
@@ -0,0 +1,141 @@
+package quaternion
+
+import (
+	"math"
+)
+
+// EulerOrder identifies one of the twelve Tait-Bryan (all axes distinct) or
+// proper Euler (first and third axis the same) angle sequences.
+type EulerOrder int
+
+// The six Tait-Bryan orders (all three axes distinct) and six proper Euler
+// orders (first and third rotation share an axis), matching the
+// conventions used by robotics and DCC tools such as SciPy's Rotation.
+const (
+	OrderXYZ EulerOrder = iota
+	OrderXZY
+	OrderYXZ
+	OrderYZX
+	OrderZXY
+	OrderZYX
+	OrderXYX
+	OrderXZX
+	OrderYXY
+	OrderYZY
+	OrderZXZ
+	OrderZYZ
+)
+
+// eulerAxes describes an EulerOrder as the axis index (0=X,1=Y,2=Z) of the
+// first rotation i, the middle rotation j, and the remaining axis k, along
+// with whether (i,j,k) is an odd permutation of (X,Y,Z) and whether the
+// order is a proper Euler order (third rotation repeats axis i instead of
+// using k).
+type eulerAxes struct {
+	i, j, k int
+	parity  bool
+	repeat  bool
+}
+
+var eulerOrderAxes = map[EulerOrder]eulerAxes{
+	OrderXYZ: {0, 1, 2, false, false},
+	OrderXZY: {0, 2, 1, true, false},
+	OrderYXZ: {1, 0, 2, true, false},
+	OrderYZX: {1, 2, 0, false, false},
+	OrderZXY: {2, 0, 1, false, false},
+	OrderZYX: {2, 1, 0, true, false},
+	OrderXYX: {0, 1, 2, false, true},
+	OrderXZX: {0, 2, 1, true, true},
+	OrderYXY: {1, 0, 2, true, true},
+	OrderYZY: {1, 2, 0, false, true},
+	OrderZXZ: {2, 0, 1, false, true},
+	OrderZYZ: {2, 1, 0, true, true},
+}
+
+// eulerGimbalEps is how close the singular-pitch matrix entries must be to
+// their degenerate value before EulerWith falls back to the canonical
+// gimbal-lock decomposition (third angle fixed at 0).
+const eulerGimbalEps = 1e-6
+
+func unitAxis(i int) [3]float64 {
+	var v [3]float64
+	v[i] = 1
+	return v
+}
+
+// FromEulerWith returns the Quaternion corresponding to angles a, b, c
+// applied in the given order. If intrinsic is true the angles are applied
+// about the rotating body axes (each subsequent rotation about the new,
+// already-rotated axis); if false they are applied about the fixed world
+// axes.
+func FromEulerWith(a, b, c float64, order EulerOrder, intrinsic bool) Quaternion {
+	ax := eulerOrderAxes[order]
+	thirdAxis := ax.k
+	if ax.repeat {
+		thirdAxis = ax.i
+	}
+	qa := FromAxisAngle(unitAxis(ax.i), a)
+	qb := FromAxisAngle(unitAxis(ax.j), b)
+	qc := FromAxisAngle(unitAxis(thirdAxis), c)
+	if intrinsic {
+		return Prod(qa, qb, qc)
+	}
+	return Prod(qc, qb, qa)
+}
+
+// EulerWith returns the Euler angles a, b, c of q for the given order and
+// intrinsic/extrinsic convention, using the generalized matrix-based
+// decomposition of Shoemake's "Euler Angle Conversion" (Graphics Gems IV).
+// At the singular pitch, where |sin(b)| (Tait-Bryan orders) or b itself
+// (proper Euler orders) is within eulerGimbalEps of its degenerate value,
+// the decomposition is not unique; EulerWith returns the canonical one with
+// c set to 0. See Quaternion.EulerWith.
+func EulerWith(qin Quaternion, order EulerOrder, intrinsic bool) (a, b, c float64) {
+	return qin.EulerWith(order, intrinsic)
+}
+
+// eulerDecompose extracts the extrinsic angles a, b, c such that
+// RotMat-equivalent M = Rk(c)*Rj(b)*Ri(a) for the axes named in ax, or
+// M = Ri(c)*Rj(b)*Ri(a) for a proper Euler order.
+func eulerDecompose(m [3][3]float64, ax eulerAxes) (a, b, c float64) {
+	i, j, k := ax.i, ax.j, ax.k
+
+	if ax.repeat {
+		sy := math.Hypot(m[i][j], m[i][k])
+		if sy > eulerGimbalEps {
+			a = math.Atan2(m[i][j], m[i][k])
+			b = math.Atan2(sy, m[i][i])
+			c = math.Atan2(m[j][i], -m[k][i])
+		} else {
+			a = math.Atan2(-m[j][k], m[j][j])
+			b = math.Atan2(sy, m[i][i])
+			c = 0
+		}
+	} else {
+		cy := math.Hypot(m[i][i], m[j][i])
+		if 1-math.Abs(m[k][i]) > eulerGimbalEps {
+			a = math.Atan2(m[k][j], m[k][k])
+			b = math.Atan2(-m[k][i], cy)
+			c = math.Atan2(m[j][i], m[i][i])
+		} else {
+			a = math.Atan2(-m[j][k], m[j][j])
+			b = math.Atan2(-m[k][i], cy)
+			c = 0
+		}
+	}
+
+	if ax.parity {
+		a, b, c = -a, -b, -c
+	}
+	return a, b, c
+}
+
+func transposeMat(m [3][3]float64) [3][3]float64 {
+	var t [3][3]float64
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			t[r][c] = m[c][r]
+		}
+	}
+	return t
+}
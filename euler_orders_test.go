@@ -0,0 +1,99 @@
+package quaternion
+
+import (
+	"math"
+	"testing"
+)
+
+var allEulerOrders = []struct {
+	order EulerOrder
+	name  string
+}{
+	{OrderXYZ, "XYZ"},
+	{OrderXZY, "XZY"},
+	{OrderYXZ, "YXZ"},
+	{OrderYZX, "YZX"},
+	{OrderZXY, "ZXY"},
+	{OrderZYX, "ZYX"},
+	{OrderXYX, "XYX"},
+	{OrderXZX, "XZX"},
+	{OrderYXY, "YXY"},
+	{OrderYZY, "YZY"},
+	{OrderZXZ, "ZXZ"},
+	{OrderZYZ, "ZYZ"},
+}
+
+// rotMatApproxEqual reports whether two rotation matrices agree to within
+// eps, which is how we compare quaternions that may differ by the
+// harmless double-cover sign flip.
+func rotMatApproxEqual(a, b [3][3]float64, eps float64) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(a[i][j]-b[i][j]) > eps {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestEulerWithRoundTrip(t *testing.T) {
+	angleSets := [][3]float64{
+		{0.3, 0.2, -0.4},
+		{-1.1, 0.6, 2.4},
+		{2.9, -0.9, -2.6},
+		{0.05, 1.3, -1.7},
+	}
+	for _, es := range allEulerOrders {
+		for _, intrinsic := range []bool{true, false} {
+			for _, a := range angleSets {
+				q := FromEulerWith(a[0], a[1], a[2], es.order, intrinsic)
+				a2, b2, c2 := EulerWith(q, es.order, intrinsic)
+				q2 := FromEulerWith(a2, b2, c2, es.order, intrinsic)
+				if !rotMatApproxEqual(RotMat(q), RotMat(q2), 1e-9) {
+					t.Errorf("order=%s intrinsic=%v angles=%v: round trip mismatch, got (%v,%v,%v)",
+						es.name, intrinsic, a, a2, b2, c2)
+				}
+			}
+		}
+	}
+}
+
+func TestEulerWithGimbalLock(t *testing.T) {
+	// Tait-Bryan orders are singular where |sin(b)| is 1 (b = +-pi/2).
+	// Proper Euler orders are singular where b is 0 or pi.
+	taitBryan := allEulerOrders[:6]
+	proper := allEulerOrders[6:]
+
+	for _, es := range taitBryan {
+		for _, intrinsic := range []bool{true, false} {
+			for _, b := range []float64{math.Pi / 2, -math.Pi / 2} {
+				q := FromEulerWith(0.7, b, -0.3, es.order, intrinsic)
+				a2, b2, c2 := EulerWith(q, es.order, intrinsic)
+				if c2 != 0 {
+					t.Errorf("order=%s intrinsic=%v b=%v: expected canonical c=0 at gimbal lock, got c=%v", es.name, intrinsic, b, c2)
+				}
+				q2 := FromEulerWith(a2, b2, c2, es.order, intrinsic)
+				if !rotMatApproxEqual(RotMat(q), RotMat(q2), 1e-9) {
+					t.Errorf("order=%s intrinsic=%v b=%v: gimbal-lock decomposition does not reproduce q", es.name, intrinsic, b)
+				}
+			}
+		}
+	}
+
+	for _, es := range proper {
+		for _, intrinsic := range []bool{true, false} {
+			for _, b := range []float64{0, math.Pi} {
+				q := FromEulerWith(0.7, b, -0.3, es.order, intrinsic)
+				a2, b2, c2 := EulerWith(q, es.order, intrinsic)
+				if c2 != 0 {
+					t.Errorf("order=%s intrinsic=%v b=%v: expected canonical c=0 at gimbal lock, got c=%v", es.name, intrinsic, b, c2)
+				}
+				q2 := FromEulerWith(a2, b2, c2, es.order, intrinsic)
+				if !rotMatApproxEqual(RotMat(q), RotMat(q2), 1e-9) {
+					t.Errorf("order=%s intrinsic=%v b=%v: gimbal-lock decomposition does not reproduce q", es.name, intrinsic, b)
+				}
+			}
+		}
+	}
+}
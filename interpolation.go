@@ -0,0 +1,87 @@
+package quaternion
+
+import (
+	"math"
+)
+
+// Dot returns the dot product of two Quaternions treated as 4-vectors. See
+// Quaternion.Dot.
+func Dot(a, b Quaternion) float64 {
+	return a.Dot(b)
+}
+
+// Neg returns the negation of a Quaternion (W,X,Y,Z) -> (-W,-X,-Y,-Z). See
+// Quaternion.Neg.
+func Neg(qin Quaternion) Quaternion {
+	return qin.Neg()
+}
+
+// Lerp returns the normalized linear interpolation between unit Quaternions
+// a and b at t in [0,1]. It is cheaper than Slerp but traces a chord rather
+// than a great-circle arc, so angular velocity is not constant.
+func Lerp(a, b Quaternion, t float64) Quaternion {
+	q := Quaternion{
+		W: a.W + t*(b.W-a.W),
+		X: a.X + t*(b.X-a.X),
+		Y: a.Y + t*(b.Y-a.Y),
+		Z: a.Z + t*(b.Z-a.Z),
+	}
+	return q.Unit()
+}
+
+// Nlerp is an alias for Lerp, named for its common use as a cheap
+// approximation to Slerp when the endpoints are close together.
+func Nlerp(a, b Quaternion, t float64) Quaternion {
+	return Lerp(a, b, t)
+}
+
+// slerpCosineThreshold is the dot-product above which Slerp falls back to
+// Nlerp to avoid dividing by a near-zero sin(theta).
+const slerpCosineThreshold = 0.9995
+
+// Slerp returns the spherical linear interpolation between unit Quaternions
+// a and b at t in [0,1]. It takes the shortest arc by negating b when
+// Dot(a,b) < 0, and falls back to Nlerp when a and b are nearly coincident.
+func Slerp(a, b Quaternion, t float64) Quaternion {
+	d := Dot(a, b)
+	if d < 0 {
+		b = Neg(b)
+		d = -d
+	}
+	if d > slerpCosineThreshold {
+		return Nlerp(a, b, t)
+	}
+	theta0 := math.Acos(d)
+	theta := theta0 * t
+	sinTheta0 := math.Sin(theta0)
+	s0 := math.Cos(theta) - d*math.Sin(theta)/sinTheta0
+	s1 := math.Sin(theta) / sinTheta0
+	return Quaternion{
+		W: s0*a.W + s1*b.W,
+		X: s0*a.X + s1*b.X,
+		Y: s0*a.Y + s1*b.Y,
+		Z: s0*a.Z + s1*b.Z,
+	}
+}
+
+// Squad returns the cubic spherical interpolation between keyframes q1 and
+// q2 at t in [0,1], using q0 and q3 as the neighbouring keyframes that
+// shape the intermediate control points. It is the quaternion analogue of
+// Catmull-Rom/Bezier spline interpolation and gives smoother angular
+// velocity across a sequence of keyframes than chained Slerp calls.
+func Squad(q0, q1, q2, q3 Quaternion, t float64) Quaternion {
+	s1 := squadControlPoint(q0, q1, q2)
+	s2 := squadControlPoint(q1, q2, q3)
+	return Slerp(Slerp(q1, q2, t), Slerp(s1, s2, t), 2*t*(1-t))
+}
+
+// squadControlPoint computes the intermediate control quaternion for qi
+// given its neighbours qPrev and qNext, as used by Squad.
+func squadControlPoint(qPrev, qi, qNext Quaternion) Quaternion {
+	qiInv := Inv(qi)
+	a := Log(Prod(qiInv, qNext))
+	b := Log(Prod(qiInv, qPrev))
+	sum := Sum(a, b)
+	exponent := Quaternion{-sum.W / 4, -sum.X / 4, -sum.Y / 4, -sum.Z / 4}
+	return Prod(qi, Exp(exponent))
+}
@@ -0,0 +1,98 @@
+package quaternion
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLerpEndpoints(t *testing.T) {
+	a := FromAxisAngle([3]float64{0, 0, 1}, 0.3)
+	b := FromAxisAngle([3]float64{1, 0, 0}, 1.1)
+	if got := Lerp(a, b, 0); !quatApproxEqualUpToSign(got, a, 1e-9) {
+		t.Errorf("Lerp(a,b,0) = %v, want %v", got, a)
+	}
+	if got := Lerp(a, b, 1); !quatApproxEqualUpToSign(got, b, 1e-9) {
+		t.Errorf("Lerp(a,b,1) = %v, want %v", got, b)
+	}
+	if got := Nlerp(a, b, 0); !quatApproxEqualUpToSign(got, a, 1e-9) {
+		t.Errorf("Nlerp(a,b,0) = %v, want %v", got, a)
+	}
+	if got := Nlerp(a, b, 1); !quatApproxEqualUpToSign(got, b, 1e-9) {
+		t.Errorf("Nlerp(a,b,1) = %v, want %v", got, b)
+	}
+}
+
+func TestSlerpEndpoints(t *testing.T) {
+	a := FromAxisAngle([3]float64{0, 1, 0}, 0.4)
+	b := FromAxisAngle([3]float64{0, 0, 1}, 2.0)
+	if got := Slerp(a, b, 0); !quatApproxEqualUpToSign(got, a, 1e-9) {
+		t.Errorf("Slerp(a,b,0) = %v, want %v", got, a)
+	}
+	if got := Slerp(a, b, 1); !quatApproxEqualUpToSign(got, b, 1e-9) {
+		t.Errorf("Slerp(a,b,1) = %v, want %v", got, b)
+	}
+}
+
+// TestSlerpShortestArc checks that Slerp negates b to take the shortest arc
+// when Dot(a,b) < 0: interpolating toward b's antipode c (where
+// Dot(a,c) > 0) must trace the identical path, since b and c = -b
+// represent the same rotation.
+func TestSlerpShortestArc(t *testing.T) {
+	a := FromAxisAngle([3]float64{0, 0, 1}, 0.3)
+	c := FromAxisAngle([3]float64{0, 0, 1}, 1.2)
+	b := Neg(c)
+	if Dot(a, b) >= 0 {
+		t.Fatalf("test setup invalid: Dot(a,b) = %v, want < 0", Dot(a, b))
+	}
+	for _, tt := range []float64{0, 0.25, 0.5, 0.75, 1} {
+		got := Slerp(a, b, tt)
+		want := Slerp(a, c, tt)
+		if !quatApproxEqualUpToSign(got, want, 1e-9) {
+			t.Errorf("t=%v: Slerp(a, Neg(c), t) = %v, want %v (same path as Slerp(a,c,t))", tt, got, want)
+		}
+	}
+}
+
+// TestSlerpNearThresholdContinuity checks that Slerp does not jump when d
+// crosses slerpCosineThreshold from the exact formula to the Nlerp
+// fallback.
+func TestSlerpNearThresholdContinuity(t *testing.T) {
+	axis := [3]float64{0, 0, 1}
+	thetaCrit := 2 * math.Acos(slerpCosineThreshold)
+	base := Quaternion{W: 1}
+	below := FromAxisAngle(axis, thetaCrit-0.0005)
+	above := FromAxisAngle(axis, thetaCrit+0.0005)
+
+	if Dot(base, below) <= slerpCosineThreshold {
+		t.Fatalf("test setup invalid: Dot(base,below) = %v, want > threshold", Dot(base, below))
+	}
+	if Dot(base, above) >= slerpCosineThreshold {
+		t.Fatalf("test setup invalid: Dot(base,above) = %v, want < threshold", Dot(base, above))
+	}
+
+	gotBelow := Slerp(base, below, 0.5)
+	gotAbove := Slerp(base, above, 0.5)
+	if !quatApproxEqual(gotBelow, gotAbove, 1e-3) {
+		t.Errorf("Slerp discontinuous across slerpCosineThreshold: below=%v above=%v", gotBelow, gotAbove)
+	}
+}
+
+func quatApproxEqual(a, b Quaternion, eps float64) bool {
+	return math.Abs(a.W-b.W) < eps && math.Abs(a.X-b.X) < eps && math.Abs(a.Y-b.Y) < eps && math.Abs(a.Z-b.Z) < eps
+}
+
+// TestSquadEndpoints checks that Squad reproduces the inner keyframes q1
+// and q2 exactly at t=0 and t=1, the defining property of the spline.
+func TestSquadEndpoints(t *testing.T) {
+	q0 := FromAxisAngle([3]float64{1, 0, 0}, 0.1)
+	q1 := FromAxisAngle([3]float64{1, 0, 0}, 0.5)
+	q2 := FromAxisAngle([3]float64{1, 0, 0}, 1.0)
+	q3 := FromAxisAngle([3]float64{1, 0, 0}, 1.3)
+
+	if got := Squad(q0, q1, q2, q3, 0); !quatApproxEqualUpToSign(got, q1, 1e-9) {
+		t.Errorf("Squad(...,0) = %v, want q1 = %v", got, q1)
+	}
+	if got := Squad(q0, q1, q2, q3, 1); !quatApproxEqualUpToSign(got, q2, 1e-9) {
+		t.Errorf("Squad(...,1) = %v, want q2 = %v", got, q2)
+	}
+}
@@ -0,0 +1,225 @@
+package quaternion
+
+import "math"
+
+// This file holds the method-style API on Quaternion. The package-level
+// functions in quaternion.go and elsewhere remain as thin wrappers around
+// these methods for backward compatibility.
+
+// Conj returns the conjugate of q: (W,X,Y,Z) -> (W,-X,-Y,-Z).
+func (q Quaternion) Conj() Quaternion {
+	return Quaternion{W: q.W, X: -q.X, Y: -q.Y, Z: -q.Z}
+}
+
+// Norm2 returns the squared L2-Norm of q: W*W+X*X+Y*Y+Z*Z.
+func (q Quaternion) Norm2() float64 {
+	return q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z
+}
+
+// Norm returns the L2-Norm of q: Sqrt(W*W+X*X+Y*Y+Z*Z).
+func (q Quaternion) Norm() float64 {
+	return math.Sqrt(q.Norm2())
+}
+
+// Unit returns q rescaled to unit norm.
+func (q Quaternion) Unit() Quaternion {
+	k := q.Norm()
+	return Quaternion{q.W / k, q.X / k, q.Y / k, q.Z / k}
+}
+
+// Inv returns the inverse of q, i.e. the conjugate rescaled so that q*q.Inv() = 1.
+func (q Quaternion) Inv() Quaternion {
+	k2 := q.Norm2()
+	c := q.Conj()
+	return Quaternion{c.W / k2, c.X / k2, c.Y / k2, c.Z / k2}
+}
+
+// Add returns the vector sum a+r.
+func (a Quaternion) Add(r Quaternion) Quaternion {
+	return Quaternion{a.W + r.W, a.X + r.X, a.Y + r.Y, a.Z + r.Z}
+}
+
+// Mul returns the non-commutative quaternion product a*r.
+func (a Quaternion) Mul(r Quaternion) Quaternion {
+	return Quaternion{
+		W: a.W*r.W - a.X*r.X - a.Y*r.Y - a.Z*r.Z,
+		X: a.W*r.X + a.X*r.W + a.Y*r.Z - a.Z*r.Y,
+		Y: a.W*r.Y + a.Y*r.W + a.Z*r.X - a.X*r.Z,
+		Z: a.W*r.Z + a.Z*r.W + a.X*r.Y - a.Y*r.X,
+	}
+}
+
+// Dot returns the dot product of a and r treated as 4-vectors.
+func (a Quaternion) Dot(r Quaternion) float64 {
+	return a.W*r.W + a.X*r.X + a.Y*r.Y + a.Z*r.Z
+}
+
+// Neg returns the negation of q: (W,X,Y,Z) -> (-W,-X,-Y,-Z).
+func (q Quaternion) Neg() Quaternion {
+	return Quaternion{-q.W, -q.X, -q.Y, -q.Z}
+}
+
+// Abs returns the norm of q, computed so that it does not overflow or
+// underflow for components of extreme magnitude. It rescales by the
+// largest-magnitude component before taking the square root, the same
+// trick used by math.Hypot for complex numbers. As with math.Hypot, if any
+// component is an infinity, Abs returns +Inf even if another component is
+// NaN, so that NaN does not mask a genuine infinity.
+func (q Quaternion) Abs() float64 {
+	w, x, y, z := math.Abs(q.W), math.Abs(q.X), math.Abs(q.Y), math.Abs(q.Z)
+	if math.IsInf(w, 1) || math.IsInf(x, 1) || math.IsInf(y, 1) || math.IsInf(z, 1) {
+		return math.Inf(1)
+	}
+	r := w
+	if x > r {
+		r = x
+	}
+	if y > r {
+		r = y
+	}
+	if z > r {
+		r = z
+	}
+	if r == 0 {
+		return 0
+	}
+	w, x, y, z = w/r, x/r, y/r, z/r
+	return r * math.Sqrt(w*w+x*x+y*y+z*z)
+}
+
+// Exp returns e**q, the quaternion exponential.
+func (q Quaternion) Exp() Quaternion {
+	if q.IsNaN() {
+		return Quaternion{math.NaN(), math.NaN(), math.NaN(), math.NaN()}
+	}
+	_, nv, n, ok := polar(q)
+	e := math.Exp(q.W)
+	if !ok {
+		return Quaternion{W: e}
+	}
+	sin, cos := math.Sincos(nv)
+	return Quaternion{
+		W: e * cos,
+		X: e * sin * n.X,
+		Y: e * sin * n.Y,
+		Z: e * sin * n.Z,
+	}
+}
+
+// Log returns the quaternion logarithm of q.
+func (q Quaternion) Log() Quaternion {
+	r, nv, n, ok := polar(q)
+	if r == 0 {
+		return Quaternion{W: math.Inf(-1)}
+	}
+	theta := math.Atan2(nv, q.W)
+	if !ok {
+		if q.W >= 0 {
+			return Quaternion{W: math.Log(r)}
+		}
+		return Quaternion{W: math.Log(r), X: theta}
+	}
+	return Quaternion{
+		W: math.Log(r),
+		X: theta * n.X,
+		Y: theta * n.Y,
+		Z: theta * n.Z,
+	}
+}
+
+// Pow returns q**p, defined as Exp(p * Log(q)).
+func (q Quaternion) Pow(p Quaternion) Quaternion {
+	if q.W == 0 && q.X == 0 && q.Y == 0 && q.Z == 0 {
+		if p.W == 0 && p.X == 0 && p.Y == 0 && p.Z == 0 {
+			return Quaternion{W: 1}
+		}
+		return Quaternion{}
+	}
+	return p.Mul(q.Log()).Exp()
+}
+
+// Sqrt returns the quaternion square root of q.
+func (q Quaternion) Sqrt() Quaternion {
+	return q.Pow(Quaternion{W: 0.5})
+}
+
+// IsNaN reports whether any component of q is NaN.
+func (q Quaternion) IsNaN() bool {
+	return math.IsNaN(q.W) || math.IsNaN(q.X) || math.IsNaN(q.Y) || math.IsNaN(q.Z)
+}
+
+// IsInf reports whether any component of q is infinite.
+func (q Quaternion) IsInf() bool {
+	return math.IsInf(q.W, 0) || math.IsInf(q.X, 0) || math.IsInf(q.Y, 0) || math.IsInf(q.Z, 0)
+}
+
+// Rotate applies the rotation represented by unit Quaternion q to vector v,
+// i.e. computes q*v*Conj(q) without constructing an intermediate rotation
+// matrix. It uses the expanded Rodrigues form
+// v + 2*s*(u x v) + 2*(u x (u x v)), where s = q.W and u = (q.X,q.Y,q.Z).
+func (q Quaternion) Rotate(v [3]float64) [3]float64 {
+	u := [3]float64{q.X, q.Y, q.Z}
+	s := q.W
+
+	uxv := cross(u, v)
+	uxuxv := cross(u, uxv)
+
+	return [3]float64{
+		v[0] + 2*s*uxv[0] + 2*uxuxv[0],
+		v[1] + 2*s*uxv[1] + 2*uxuxv[1],
+		v[2] + 2*s*uxv[2] + 2*uxuxv[2],
+	}
+}
+
+// RotMat returns the rotation matrix corresponding to q.
+func (q Quaternion) RotMat() [3][3]float64 {
+	r := q.Unit()
+	m := [3][3]float64{}
+	m[0][0] = 1 - 2*(r.Y*r.Y+r.Z*r.Z)
+	m[0][1] = 2 * (r.X*r.Y - r.W*r.Z)
+	m[0][2] = 2 * (r.W*r.Y + r.X*r.Z)
+
+	m[1][1] = 1 - 2*(r.Z*r.Z+r.X*r.X)
+	m[1][2] = 2 * (r.Y*r.Z - r.W*r.X)
+	m[1][0] = 2 * (r.W*r.Z + r.Y*r.X)
+
+	m[2][2] = 1 - 2*(r.X*r.X+r.Y*r.Y)
+	m[2][0] = 2 * (r.Z*r.X - r.W*r.Y)
+	m[2][1] = 2 * (r.W*r.X + r.Z*r.Y)
+	return m
+}
+
+// Euler returns the Euler angles phi, theta, psi corresponding to q, using
+// the fixed-axis (extrinsic) X-Y-Z convention. For other orderings and the
+// intrinsic/extrinsic distinction, see EulerWith.
+func (q Quaternion) Euler() (phi, theta, psi float64) {
+	r := q.Unit()
+	phi = math.Atan2(2*(r.W*r.X+r.Y*r.Z), 1-2*(r.X*r.X+r.Y*r.Y))
+	theta = math.Asin(2 * (r.W*r.Y - r.Z*r.X))
+	psi = math.Atan2(2*(r.X*r.Y+r.W*r.Z), 1-2*(r.Y*r.Y+r.Z*r.Z))
+	return phi, theta, psi
+}
+
+// EulerWith returns the Euler angles a, b, c of q for the given order and
+// intrinsic/extrinsic convention, using the generalized matrix-based
+// decomposition of Shoemake's "Euler Angle Conversion" (Graphics Gems IV).
+// At the singular pitch, where |sin(b)| (Tait-Bryan orders) or b itself
+// (proper Euler orders) is within eulerGimbalEps of its degenerate value,
+// the decomposition is not unique; EulerWith returns the canonical one with
+// c set to 0.
+func (q Quaternion) EulerWith(order EulerOrder, intrinsic bool) (a, b, c float64) {
+	ax := eulerOrderAxes[order]
+	m := q.RotMat()
+	if intrinsic {
+		// q = Ri(a)*Rj(b)*Rk(c) (Ri again in place of Rk for a proper Euler
+		// order), so transposing gives Rk(-c)*Rj(-b)*Ri(-a),
+		// which is exactly the extrinsic form decoded by eulerDecompose with
+		// the same axis order, negated.
+		m = transposeMat(m)
+	}
+	a, b, c = eulerDecompose(m, ax)
+	if intrinsic {
+		a, b, c = -a, -b, -c
+	}
+	return a, b, c
+}
@@ -22,22 +22,17 @@ type Quaternion struct {
 
 // Conj returns the conjugate of a Quaternion (W,X,Y,Z) -> (W,-X,-Y,-Z)
 func Conj(qin Quaternion) Quaternion {
-	qout := Quaternion{}
-	qout.W = +qin.W
-	qout.X = -qin.X
-	qout.Y = -qin.Y
-	qout.Z = -qin.Z
-	return qout
+	return qin.Conj()
 }
 
 // Norm2 returns the L2-Norm of a Quaternion (W,X,Y,Z) -> W*W+X*X+Y*Y+Z*Z
 func Norm2(qin Quaternion) float64 {
-	return qin.W*qin.W + qin.X*qin.X + qin.Y*qin.Y + qin.Z*qin.Z
+	return qin.Norm2()
 }
 
 // Norm returns the L1-Norm of a Quaternion (W,X,Y,Z) -> Sqrt(W*W+X*X+Y*Y+Z*Z)
 func Norm(qin Quaternion) float64 {
-	return math.Sqrt(qin.W*qin.W + qin.X*qin.X + qin.Y*qin.Y + qin.Z*qin.Z)
+	return qin.Norm()
 }
 
 // Scalar returns a scalar-only Quaternion representation of a float (W,0,0,0)
@@ -49,10 +44,7 @@ func Scalar(w float64) Quaternion {
 func Sum(qin ...Quaternion) Quaternion {
 	qout := Quaternion{}
 	for _, q := range qin {
-		qout.W += q.W
-		qout.X += q.X
-		qout.Y += q.Y
-		qout.Z += q.Z
+		qout = qout.Add(q)
 	}
 	return qout
 }
@@ -60,40 +52,36 @@ func Sum(qin ...Quaternion) Quaternion {
 // Prod returns the non-commutative product of any number of Quaternions
 func Prod(qin ...Quaternion) Quaternion {
 	qout := Quaternion{1, 0, 0, 0}
-	var w, x, y, z float64
 	for _, q := range qin {
-		w = qout.W*q.W - qout.X*q.X - qout.Y*q.Y - qout.Z*q.Z
-		x = qout.W*q.X + qout.X*q.W + qout.Y*q.Z - qout.Z*q.Y
-		y = qout.W*q.Y + qout.Y*q.W + qout.Z*q.X - qout.X*q.Z
-		z = qout.W*q.Z + qout.Z*q.W + qout.X*q.Y - qout.Y*q.X
-		qout = Quaternion{w, x, y, z}
+		qout = qout.Mul(q)
 	}
 	return qout
 }
 
 // Unit returns the Quaternion rescaled to unit-L1-norm
 func Unit(qin Quaternion) Quaternion {
-	k := Norm(qin)
-	return Quaternion{qin.W / k, qin.X / k, qin.Y / k, qin.Z / k}
+	return qin.Unit()
 }
 
 // Inv returns the Quaternion conjugate rescaled so that Q Q* = 1
 func Inv(qin Quaternion) Quaternion {
-	k2 := Norm2(qin)
-	q := Conj(qin)
-	return Quaternion{q.W / k2, q.X / k2, q.Y / k2, q.Z / k2}
+	return qin.Inv()
 }
 
-// Euler returns the Euler angles phi, theta, psi corresponding to a Quaternion
+// Euler returns the Euler angles phi, theta, psi corresponding to a
+// Quaternion, using the fixed-axis (extrinsic) X-Y-Z convention. For other
+// orderings and the intrinsic/extrinsic distinction, see EulerWith.
 func Euler(q Quaternion) (float64, float64, float64) {
-	r := Unit(q)
+	r := q.Unit()
 	phi := math.Atan2(2*(r.W*r.X+r.Y*r.Z), 1-2*(r.X*r.X+r.Y*r.Y))
 	theta := math.Asin(2 * (r.W*r.Y - r.Z*r.X))
 	psi := math.Atan2(2*(r.X*r.Y+r.W*r.Z), 1-2*(r.Y*r.Y+r.Z*r.Z))
 	return phi, theta, psi
 }
 
-// FromEuler returns a Quaternion corresponding to Euler angles phi, theta, psi
+// FromEuler returns a Quaternion corresponding to Euler angles phi, theta,
+// psi, using the fixed-axis (extrinsic) X-Y-Z convention. For other
+// orderings and the intrinsic/extrinsic distinction, see FromEulerWith.
 func FromEuler(phi, theta, psi float64) Quaternion {
 	q := Quaternion{}
 	q.W = math.Cos(phi/2)*math.Cos(theta/2)*math.Cos(psi/2) +
@@ -109,18 +97,5 @@ func FromEuler(phi, theta, psi float64) Quaternion {
 
 // RotMat returns the rotation matrix (as float array) corresponding to a Quaternion
 func RotMat(qin Quaternion) [3][3]float64 {
-	q := Unit(qin)
-	m := [3][3]float64{}
-	m[0][0] = 1 - 2*(q.Y*q.Y+q.Z*q.Z)
-	m[0][1] = 2 * (q.X*q.Y - q.W*q.Z)
-	m[0][2] = 2 * (q.W*q.Y + q.X*q.Z)
-
-	m[1][1] = 1 - 2*(q.Z*q.Z+q.X*q.X)
-	m[1][2] = 2 * (q.Y*q.Z - q.W*q.X)
-	m[1][0] = 2 * (q.W*q.Z + q.Y*q.X)
-
-	m[2][2] = 1 - 2*(q.X*q.X+q.Y*q.Y)
-	m[2][0] = 2 * (q.Z*q.X - q.W*q.Y)
-	m[2][1] = 2 * (q.W*q.X + q.Z*q.Y)
-	return m
+	return qin.RotMat()
 }
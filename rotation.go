@@ -0,0 +1,136 @@
+package quaternion
+
+import (
+	"math"
+)
+
+// FromAxisAngle returns the unit Quaternion representing a rotation of
+// angle radians about axis. axis need not be normalized.
+func FromAxisAngle(axis [3]float64, angle float64) Quaternion {
+	n := math.Sqrt(axis[0]*axis[0] + axis[1]*axis[1] + axis[2]*axis[2])
+	if n == 0 {
+		return Quaternion{W: 1}
+	}
+	sin, cos := math.Sincos(angle / 2)
+	s := sin / n
+	return Quaternion{W: cos, X: axis[0] * s, Y: axis[1] * s, Z: axis[2] * s}
+}
+
+// ToAxisAngle returns the axis and angle of rotation represented by q. If q
+// is the identity rotation, axis is the arbitrary unit vector (1,0,0) and
+// angle is 0.
+func ToAxisAngle(qin Quaternion) (axis [3]float64, angle float64) {
+	q := qin.Unit()
+	if q.W < 0 {
+		q = q.Neg()
+	}
+	sin := math.Sqrt(q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	angle = 2 * math.Atan2(sin, q.W)
+	if sin < 1e-9 {
+		return [3]float64{1, 0, 0}, angle
+	}
+	return [3]float64{q.X / sin, q.Y / sin, q.Z / sin}, angle
+}
+
+// Rotate applies the rotation represented by unit Quaternion q to vector v.
+// See Quaternion.Rotate.
+func Rotate(q Quaternion, v [3]float64) [3]float64 {
+	return q.Rotate(v)
+}
+
+// FromRotMat returns the unit Quaternion corresponding to rotation matrix m,
+// using Shepperd's method: it picks whichever of W, X, Y, or Z has the
+// largest magnitude (by comparing the matrix trace and diagonal) before
+// taking its square root, then derives the remaining three components from
+// the off-diagonal sums and differences. This avoids the catastrophic
+// cancellation that a direct formula suffers when W is near zero.
+func FromRotMat(m [3][3]float64) Quaternion {
+	trace := m[0][0] + m[1][1] + m[2][2]
+	switch {
+	case trace > m[0][0] && trace > m[1][1] && trace > m[2][2]:
+		s := 0.5 / math.Sqrt(1+trace)
+		return Quaternion{
+			W: 0.25 / s,
+			X: (m[2][1] - m[1][2]) * s,
+			Y: (m[0][2] - m[2][0]) * s,
+			Z: (m[1][0] - m[0][1]) * s,
+		}
+	case m[0][0] > m[1][1] && m[0][0] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[0][0]-m[1][1]-m[2][2])
+		return Quaternion{
+			W: (m[2][1] - m[1][2]) / s,
+			X: 0.25 * s,
+			Y: (m[0][1] + m[1][0]) / s,
+			Z: (m[0][2] + m[2][0]) / s,
+		}
+	case m[1][1] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[1][1]-m[0][0]-m[2][2])
+		return Quaternion{
+			W: (m[0][2] - m[2][0]) / s,
+			X: (m[0][1] + m[1][0]) / s,
+			Y: 0.25 * s,
+			Z: (m[1][2] + m[2][1]) / s,
+		}
+	default:
+		s := 2 * math.Sqrt(1+m[2][2]-m[0][0]-m[1][1])
+		return Quaternion{
+			W: (m[1][0] - m[0][1]) / s,
+			X: (m[0][2] + m[2][0]) / s,
+			Y: (m[1][2] + m[2][1]) / s,
+			Z: 0.25 * s,
+		}
+	}
+}
+
+// FromTo returns the unit Quaternion representing the shortest rotation
+// that takes vector from to vector to. from and to need not be normalized.
+// If from and to are antiparallel, any axis perpendicular to from is used
+// for a half-turn rotation, since the shortest arc is not unique.
+func FromTo(from, to [3]float64) Quaternion {
+	fn := normalize(from)
+	tn := normalize(to)
+
+	d := dot(fn, tn)
+	if d > 1-1e-12 {
+		return Quaternion{W: 1}
+	}
+	if d < -1+1e-12 {
+		axis := perpendicular(fn)
+		return FromAxisAngle(axis, math.Pi)
+	}
+
+	axis := cross(fn, tn)
+	w := 1 + d
+	return Quaternion{W: w, X: axis[0], Y: axis[1], Z: axis[2]}.Unit()
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func normalize(v [3]float64) [3]float64 {
+	n := math.Sqrt(dot(v, v))
+	if n == 0 {
+		return v
+	}
+	return [3]float64{v[0] / n, v[1] / n, v[2] / n}
+}
+
+// perpendicular returns an arbitrary unit vector perpendicular to unit
+// vector v, used as a rotation axis when v points exactly opposite another
+// vector and no unique axis exists.
+func perpendicular(v [3]float64) [3]float64 {
+	axis := cross([3]float64{1, 0, 0}, v)
+	if dot(axis, axis) < 1e-12 {
+		axis = cross([3]float64{0, 1, 0}, v)
+	}
+	return normalize(axis)
+}
@@ -0,0 +1,78 @@
+package quaternion
+
+import (
+	"math"
+	"testing"
+)
+
+func vecApproxEqual(a, b [3]float64, eps float64) bool {
+	return math.Abs(a[0]-b[0]) < eps && math.Abs(a[1]-b[1]) < eps && math.Abs(a[2]-b[2]) < eps
+}
+
+func TestAxisAngleRoundTrip(t *testing.T) {
+	cases := []struct {
+		axis  [3]float64
+		angle float64
+	}{
+		{[3]float64{1, 0, 0}, 1.2},
+		{[3]float64{0, 1, 0}, -2.3},
+		{[3]float64{0, 0, 1}, 0.7},
+		{[3]float64{1, 1, 1}, 2.5},
+		{[3]float64{1, -2, 3}, -0.9},
+	}
+	for _, c := range cases {
+		// ToAxisAngle always reports a non-negative angle, flipping the
+		// axis to compensate when the input angle was negative.
+		want := normalize(c.axis)
+		if c.angle < 0 {
+			want = [3]float64{-want[0], -want[1], -want[2]}
+		}
+		q := FromAxisAngle(c.axis, c.angle)
+		axis, angle := ToAxisAngle(q)
+		if !vecApproxEqual(axis, want, 1e-9) || math.Abs(angle-math.Abs(c.angle)) > 1e-9 {
+			t.Errorf("axis=%v angle=%v: round trip gave axis=%v angle=%v", c.axis, c.angle, axis, angle)
+		}
+	}
+}
+
+func TestAxisAngleIdentity(t *testing.T) {
+	axis, angle := ToAxisAngle(Quaternion{W: 1})
+	if angle != 0 || axis != ([3]float64{1, 0, 0}) {
+		t.Errorf("ToAxisAngle(identity) = axis=%v angle=%v, want axis={1 0 0} angle=0", axis, angle)
+	}
+}
+
+func TestRotateRoundTrip(t *testing.T) {
+	q := FromAxisAngle([3]float64{0, 0, 1}, math.Pi/2)
+	v := [3]float64{1, 0, 0}
+	got := q.Rotate(v)
+	want := [3]float64{0, 1, 0}
+	if !vecApproxEqual(got, want, 1e-9) {
+		t.Errorf("Rotate(90deg about Z, (1,0,0)) = %v, want %v", got, want)
+	}
+	back := q.Inv().Rotate(got)
+	if !vecApproxEqual(back, v, 1e-9) {
+		t.Errorf("Rotate then inverse-Rotate = %v, want original %v", back, v)
+	}
+}
+
+func TestFromTo(t *testing.T) {
+	cases := []struct {
+		name string
+		from [3]float64
+		to   [3]float64
+	}{
+		{"same", [3]float64{1, 0, 0}, [3]float64{2, 0, 0}},
+		{"perpendicular", [3]float64{1, 0, 0}, [3]float64{0, 1, 0}},
+		{"antiparallel", [3]float64{1, 0, 0}, [3]float64{-1, 0, 0}},
+		{"generic", [3]float64{1, 2, 3}, [3]float64{-2, 1, 0.5}},
+	}
+	for _, c := range cases {
+		q := FromTo(c.from, c.to)
+		got := q.Rotate(normalize(c.from))
+		want := normalize(c.to)
+		if !vecApproxEqual(got, want, 1e-9) {
+			t.Errorf("%s: FromTo(%v,%v).Rotate(from) = %v, want %v", c.name, c.from, c.to, got, want)
+		}
+	}
+}
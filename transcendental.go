@@ -0,0 +1,88 @@
+package quaternion
+
+import (
+	"math"
+)
+
+// Abs returns the norm of a Quaternion, computed so that it does not
+// overflow or underflow for components of extreme magnitude. See
+// Quaternion.Abs.
+func Abs(qin Quaternion) float64 {
+	return qin.Abs()
+}
+
+// IsNaN reports whether any component of q is NaN.
+func IsNaN(q Quaternion) bool {
+	return q.IsNaN()
+}
+
+// IsInf reports whether any component of q is infinite.
+func IsInf(q Quaternion) bool {
+	return q.IsInf()
+}
+
+// polar decomposes q into its norm r, the norm nv of its vector part, and
+// the unit vector part n, used by Quaternion.Exp, Quaternion.Log, and
+// Quaternion.Pow. It returns ok=false when the vector part is zero, in
+// which case q is purely real and has no unique axis. nv is computed by
+// rescaling by its own largest-magnitude component first, the same trick
+// Quaternion.Abs uses, so it stays finite for components of extreme
+// magnitude; it is deliberately computed independently of r so that a
+// purely real q (including one with an infinite W) still yields nv=0.
+func polar(q Quaternion) (r, nv float64, n Quaternion, ok bool) {
+	r = q.Abs()
+	x, y, z := math.Abs(q.X), math.Abs(q.Y), math.Abs(q.Z)
+	if math.IsInf(x, 1) || math.IsInf(y, 1) || math.IsInf(z, 1) {
+		// At least one vector component is infinite, so it alone
+		// dominates both nv and the direction; the same rescale-by-max
+		// trick used below would divide Inf by Inf and produce NaN.
+		nv = math.Inf(1)
+		nx, ny, nz := 0.0, 0.0, 0.0
+		if math.IsInf(x, 1) {
+			nx = math.Copysign(1, q.X)
+		}
+		if math.IsInf(y, 1) {
+			ny = math.Copysign(1, q.Y)
+		}
+		if math.IsInf(z, 1) {
+			nz = math.Copysign(1, q.Z)
+		}
+		k := math.Sqrt(nx*nx + ny*ny + nz*nz)
+		return r, nv, Quaternion{X: nx / k, Y: ny / k, Z: nz / k}, true
+	}
+	m := x
+	if y > m {
+		m = y
+	}
+	if z > m {
+		m = z
+	}
+	if m == 0 {
+		return r, 0, Quaternion{}, false
+	}
+	x, y, z = q.X/m, q.Y/m, q.Z/m
+	nv = m * math.Sqrt(x*x+y*y+z*z)
+	n = Quaternion{X: q.X / nv, Y: q.Y / nv, Z: q.Z / nv}
+	return r, nv, n, true
+}
+
+// Exp returns e**q, the quaternion exponential. See Quaternion.Exp.
+func Exp(q Quaternion) Quaternion {
+	return q.Exp()
+}
+
+// Log returns the quaternion logarithm of q. See Quaternion.Log.
+func Log(q Quaternion) Quaternion {
+	return q.Log()
+}
+
+// Pow returns q**p for quaternion q and p, defined as Exp(p * Log(q)). See
+// Quaternion.Pow.
+func Pow(q, p Quaternion) Quaternion {
+	return q.Pow(p)
+}
+
+// Sqrt returns the quaternion square root of q. See Quaternion.Sqrt.
+func Sqrt(q Quaternion) Quaternion {
+	return q.Sqrt()
+}
@@ -0,0 +1,159 @@
+package quaternion
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIsNaN(t *testing.T) {
+	cases := []struct {
+		name string
+		q    Quaternion
+		want bool
+	}{
+		{"zero", Quaternion{}, false},
+		{"finite", Quaternion{W: 1, X: 2, Y: 3, Z: 4}, false},
+		{"inf", Quaternion{W: math.Inf(1)}, false},
+		{"nan W", Quaternion{W: math.NaN()}, true},
+		{"nan X", Quaternion{X: math.NaN()}, true},
+		{"nan Y", Quaternion{Y: math.NaN()}, true},
+		{"nan Z", Quaternion{Z: math.NaN()}, true},
+	}
+	for _, c := range cases {
+		if got := IsNaN(c.q); got != c.want {
+			t.Errorf("%s: IsNaN(%v) = %v, want %v", c.name, c.q, got, c.want)
+		}
+	}
+}
+
+func TestIsInf(t *testing.T) {
+	cases := []struct {
+		name string
+		q    Quaternion
+		want bool
+	}{
+		{"zero", Quaternion{}, false},
+		{"finite", Quaternion{W: 1, X: 2, Y: 3, Z: 4}, false},
+		{"nan", Quaternion{W: math.NaN()}, false},
+		{"inf W", Quaternion{W: math.Inf(1)}, true},
+		{"-inf X", Quaternion{X: math.Inf(-1)}, true},
+		{"inf Y", Quaternion{Y: math.Inf(1)}, true},
+		{"inf Z", Quaternion{Z: math.Inf(1)}, true},
+	}
+	for _, c := range cases {
+		if got := IsInf(c.q); got != c.want {
+			t.Errorf("%s: IsInf(%v) = %v, want %v", c.name, c.q, got, c.want)
+		}
+	}
+}
+
+func TestExpNaNInfPropagation(t *testing.T) {
+	cases := []struct {
+		name string
+		q    Quaternion
+	}{
+		{"nan", Quaternion{W: math.NaN(), X: 1}},
+		{"inf real", Quaternion{W: math.Inf(1)}},
+	}
+	for _, c := range cases {
+		got := Exp(c.q)
+		if !got.IsNaN() && !got.IsInf() {
+			t.Errorf("%s: Exp(%v) = %v, want NaN or Inf propagation", c.name, c.q, got)
+		}
+	}
+}
+
+func TestExpInfRealOnly(t *testing.T) {
+	// A purely real infinite quaternion has a zero vector part and so no
+	// unique rotation axis; Exp must not let that lost axis information
+	// turn into NaN in the X/Y/Z components.
+	got := Exp(Quaternion{W: math.Inf(1)})
+	if !math.IsInf(got.W, 1) || got.X != 0 || got.Y != 0 || got.Z != 0 {
+		t.Errorf("Exp(Inf real) = %v, want {+Inf 0 0 0}", got)
+	}
+}
+
+func TestExpZeroVectorPart(t *testing.T) {
+	// A purely real quaternion should exponentiate like a real scalar: no
+	// unique rotation axis, so the vector part stays zero.
+	got := Exp(Quaternion{W: 2})
+	want := math.Exp(2)
+	if math.Abs(got.W-want) > 1e-12 || got.X != 0 || got.Y != 0 || got.Z != 0 {
+		t.Errorf("Exp(pure real 2) = %v, want {%v 0 0 0}", got, want)
+	}
+}
+
+func TestLogZero(t *testing.T) {
+	got := Log(Quaternion{})
+	if !math.IsInf(got.W, -1) {
+		t.Errorf("Log(0) = %v, want W = -Inf", got)
+	}
+}
+
+func TestLogInfVectorComponent(t *testing.T) {
+	got := Log(Quaternion{W: 1, X: math.Inf(1)})
+	if !math.IsInf(got.W, 1) || got.IsNaN() {
+		t.Errorf("Log({1, +Inf, 0, 0}) = %v, want a finite direction with W = +Inf", got)
+	}
+}
+
+func TestLogInfRealOnly(t *testing.T) {
+	got := Log(Quaternion{W: math.Inf(1)})
+	if !math.IsInf(got.W, 1) || got.X != 0 || got.Y != 0 || got.Z != 0 {
+		t.Errorf("Log(Inf real) = %v, want {+Inf 0 0 0}", got)
+	}
+}
+
+func TestLogNegativeRealZeroVector(t *testing.T) {
+	// log(-r) for real r<0 has no unique imaginary axis; the package picks
+	// the X axis as the canonical branch (see how Quaternion.Log handles
+	// polar's ok=false result).
+	got := Log(Quaternion{W: -2})
+	if math.Abs(got.X-math.Pi) > 1e-12 {
+		t.Errorf("Log(-2) = %v, want X = Pi", got)
+	}
+}
+
+func TestLogOverflowScale(t *testing.T) {
+	big := 1e300
+	got := Log(Quaternion{W: big, X: big})
+	if got.IsNaN() || got.IsInf() {
+		t.Errorf("Log of overflow-scale quaternion = %v, want a finite result", got)
+	}
+}
+
+func TestPowZeroBase(t *testing.T) {
+	if got := Pow(Quaternion{}, Quaternion{}); got != (Quaternion{W: 1}) {
+		t.Errorf("Pow(0,0) = %v, want {1 0 0 0}", got)
+	}
+	if got := Pow(Quaternion{}, Quaternion{W: 2}); got != (Quaternion{}) {
+		t.Errorf("Pow(0,2) = %v, want {0 0 0 0}", got)
+	}
+}
+
+func TestPowNaNInfPropagation(t *testing.T) {
+	got := Pow(Quaternion{W: math.NaN()}, Quaternion{W: 2})
+	if !got.IsNaN() {
+		t.Errorf("Pow(NaN,2) = %v, want NaN propagation", got)
+	}
+}
+
+func TestSqrtZeroVectorPart(t *testing.T) {
+	got := Sqrt(Quaternion{W: 4})
+	want := 2.0
+	if math.Abs(got.W-want) > 1e-12 || got.X != 0 || got.Y != 0 || got.Z != 0 {
+		t.Errorf("Sqrt(4) = %v, want {%v 0 0 0}", got, want)
+	}
+}
+
+func TestSqrtOverflowScale(t *testing.T) {
+	big := 1e200
+	got := Sqrt(Quaternion{W: big, X: big, Y: big, Z: big})
+	if got.IsNaN() || got.IsInf() {
+		t.Errorf("Sqrt of overflow-scale quaternion = %v, want a finite result", got)
+	}
+	q := Quaternion{W: big, X: big, Y: big, Z: big}
+	if got2 := got.Mul(got); math.Abs(got2.W-q.W) > big*1e-6 {
+		t.Errorf("Sqrt(q)^2 = %v, want approximately q = %v", got2, q)
+	}
+}